@@ -0,0 +1,111 @@
+package catalog
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// applicationIndexPath is the path, relative to a registry's base URL, that
+// serves the list of application manifests it hosts. It follows the same
+// convention as the devfile component index ("/v2index").
+const applicationIndexPath = "/v2index/application"
+
+// DevfileApplicationComponentType is a single component reference inside a
+// DevfileApplicationType manifest. It points at a devfile component, by name,
+// in a given registry, and may declare other components in the same
+// application it depends on.
+type DevfileApplicationComponentType struct {
+	// ComponentName is the name this component is known by within the
+	// application, used to resolve DependsOn references. It has its own JSON
+	// tag, distinct from the embedded DevfileComponentType's "name", so the
+	// two don't collide: a field tagged the same as a promoted field at a
+	// shallower depth shadows the promoted one for both access and
+	// json.Unmarshal, leaving the embedded Name permanently empty.
+	ComponentName string `json:"componentName" yaml:"componentName"`
+	// DependsOn lists the names of other components in the application that
+	// must be provisioned before this one
+	DependsOn []string `json:"dependsOn,omitempty" yaml:"dependsOn,omitempty"`
+	// DevfileComponentType is the underlying devfile component this reference resolves to
+	DevfileComponentType `json:",inline" yaml:",inline"`
+}
+
+// DevfileApplicationType represents an "application" entry in a devfile
+// registry's index: a named bundle of several component devfiles, together
+// with the dependencies between them.
+type DevfileApplicationType struct {
+	Name       string                            `json:"name" yaml:"name"`
+	Registry   Registry                          `json:"registry" yaml:"registry"`
+	Components []DevfileApplicationComponentType `json:"components" yaml:"components"`
+}
+
+// DevfileApplicationTypeList is a list of applications, typically resulting
+// from a registry search or listing
+type DevfileApplicationTypeList struct {
+	DevfileRegistries []Registry
+	Items             []DevfileApplicationType
+}
+
+// ListDevfileApplications lists all the applications found in the given devfile
+// registry, or in all configured registries if registryName is empty. It
+// mirrors ListDevfileComponents but queries the "applications" index instead
+// of the component index.
+func ListDevfileApplications(registryName string) (DevfileApplicationTypeList, error) {
+	catalogApplicationList := DevfileApplicationTypeList{}
+
+	// Reuse the registries already configured for devfile components rather
+	// than re-resolving them from preferences, so "application" and
+	// "component" entries always come from the same registry set.
+	catalogDevfileList, err := ListDevfileComponents(registryName)
+	if err != nil {
+		return catalogApplicationList, err
+	}
+	if len(catalogDevfileList.DevfileRegistries) == 0 {
+		return catalogApplicationList, nil
+	}
+	catalogApplicationList.DevfileRegistries = catalogDevfileList.DevfileRegistries
+
+	for _, reg := range catalogDevfileList.DevfileRegistries {
+		applications, err := getRegistryApplications(reg)
+		if err != nil {
+			// Most registries don't serve an application index at all yet, so
+			// treat this the same as the s2i/OAM lookups elsewhere in catalog:
+			// best-effort, skip and move on rather than failing the whole list.
+			klog.V(4).Infof("Unable to list applications from registry %s: %v", reg.Name, err)
+			continue
+		}
+		catalogApplicationList.Items = append(catalogApplicationList.Items, applications...)
+	}
+
+	return catalogApplicationList, nil
+}
+
+// getRegistryApplications fetches and decodes the application index served
+// by a single devfile registry
+func getRegistryApplications(reg Registry) ([]DevfileApplicationType, error) {
+	resp, err := http.Get(reg.URL + applicationIndexPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to fetch application index from registry %s", reg.Name)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to fetch application index from registry %s: unexpected status %s", reg.Name, resp.Status)
+	}
+
+	var applications []DevfileApplicationType
+	if err := json.NewDecoder(resp.Body).Decode(&applications); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode application index from registry %s", reg.Name)
+	}
+
+	for i := range applications {
+		applications[i].Registry = reg
+		for j := range applications[i].Components {
+			applications[i].Components[j].Registry = reg
+		}
+	}
+
+	return applications, nil
+}
@@ -0,0 +1,191 @@
+package catalog
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// OAM group/version used to look up ComponentDefinition, WorkloadDefinition
+// and ScopeDefinition objects published by an in-cluster OAM control plane
+// (Crossplane oam-kubernetes-runtime and compatible implementations)
+const (
+	oamGroup   = "core.oam.dev"
+	oamVersion = "v1alpha2"
+)
+
+var (
+	componentDefinitionGVR = schema.GroupVersionResource{Group: oamGroup, Version: oamVersion, Resource: "componentdefinitions"}
+	workloadDefinitionGVR  = schema.GroupVersionResource{Group: oamGroup, Version: oamVersion, Resource: "workloaddefinitions"}
+	scopeDefinitionGVR     = schema.GroupVersionResource{Group: oamGroup, Version: oamVersion, Resource: "scopedefinitions"}
+)
+
+// OAMComponentType represents a ComponentDefinition (and the WorkloadDefinition
+// it references) published through an in-cluster OAM control plane, surfaced
+// as a third class of catalog component alongside devfile and s2i entries.
+type OAMComponentType struct {
+	// Name of the ComponentDefinition
+	Name string
+	// WorkloadGVR is the group/version/resource of the workload this component
+	// provisions. A WorkloadDefinition only ever carries the plain
+	// "<plural>.<group>" CRD name of its workload (no version, no Kind), so a
+	// GroupVersionResource is what can actually be resolved here without a
+	// RESTMapper/discovery client; resolving a full GroupVersionKind would
+	// require one.
+	WorkloadGVR schema.GroupVersionResource
+	// Parameters are the parameters declared on the ComponentDefinition's CUE schematic
+	Parameters []OAMParameter
+	// Traits lists the trait definitions attached to this component, by name,
+	// as read from the ComponentDefinition's spec.extension.traits
+	Traits []string
+	// Scopes lists the names of all ScopeDefinitions on the cluster; it is not
+	// narrowed to the scopes this component's workload is placed in
+	Scopes []string
+}
+
+// OAMParameter is a single parameter declared on a ComponentDefinition's schema
+type OAMParameter struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Required bool   `json:"required,omitempty"`
+}
+
+// OAMComponentTypeList is the result of listing ComponentDefinitions from the cluster
+type OAMComponentTypeList struct {
+	Items []OAMComponentType
+}
+
+// IsOAMSupported returns true if the cluster has the OAM core.oam.dev API
+// group registered, i.e. an OAM control plane is installed.
+func IsOAMSupported(client dynamic.Interface) bool {
+	_, err := client.Resource(componentDefinitionGVR).List(context.TODO(), metav1.ListOptions{Limit: 1})
+	return err == nil
+}
+
+// GetOAMComponent fetches a single ComponentDefinition by name and resolves
+// its WorkloadDefinition, parameters and attached traits. Scopes is filled
+// with every ScopeDefinition on the cluster, not only those that apply to
+// this component.
+func GetOAMComponent(client dynamic.Interface, name string) (OAMComponentType, error) {
+	oamComponent := OAMComponentType{}
+
+	unstructuredDefinition, err := client.Resource(componentDefinitionGVR).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return oamComponent, errors.Errorf("no ComponentDefinition named \"%s\" found on the cluster", name)
+		}
+		return oamComponent, errors.Wrapf(err, "failed to get ComponentDefinition \"%s\"", name)
+	}
+
+	oamComponent.Name = name
+
+	workloadRef, found, err := unstructured.NestedMap(unstructuredDefinition.Object, "spec", "workload", "definitionRef")
+	if err != nil {
+		return oamComponent, errors.Wrapf(err, "failed to read workload reference for ComponentDefinition \"%s\"", name)
+	}
+	if found {
+		if workloadName, ok := workloadRef["name"].(string); ok {
+			gvr, err := resolveWorkloadGVR(client, workloadName)
+			if err != nil {
+				return oamComponent, err
+			}
+			oamComponent.WorkloadGVR = gvr
+		}
+	}
+
+	if template, found, err := unstructured.NestedString(unstructuredDefinition.Object, "spec", "schematic", "cue", "template"); err == nil && found {
+		oamComponent.Parameters = parseCUEParameters(template)
+	}
+
+	if extension, found, _ := unstructured.NestedMap(unstructuredDefinition.Object, "spec", "extension"); found {
+		if raw, ok := extension["traits"]; ok {
+			if names, ok := raw.([]interface{}); ok {
+				for _, n := range names {
+					if s, ok := n.(string); ok {
+						oamComponent.Traits = append(oamComponent.Traits, s)
+					}
+				}
+			}
+		}
+	}
+
+	scopeDefinitions, err := listScopeDefinitionNames(client)
+	if err != nil {
+		return oamComponent, err
+	}
+	oamComponent.Scopes = scopeDefinitions
+
+	return oamComponent, nil
+}
+
+// resolveWorkloadGVR looks up the WorkloadDefinition with the given name and
+// returns the group/resource of the workload it describes.
+func resolveWorkloadGVR(client dynamic.Interface, workloadDefinitionName string) (schema.GroupVersionResource, error) {
+	unstructuredWorkload, err := client.Resource(workloadDefinitionGVR).Get(context.TODO(), workloadDefinitionName, metav1.GetOptions{})
+	if err != nil {
+		return schema.GroupVersionResource{}, errors.Wrapf(err, "failed to get WorkloadDefinition \"%s\"", workloadDefinitionName)
+	}
+
+	definitionRef, found, err := unstructured.NestedString(unstructuredWorkload.Object, "spec", "definitionRef", "name")
+	if err != nil || !found {
+		return schema.GroupVersionResource{}, errors.Errorf("WorkloadDefinition \"%s\" has no definitionRef", workloadDefinitionName)
+	}
+
+	// definitionRef.name is the plain Kubernetes CRD name "<plural>.<group>"
+	// (e.g. "deployments.apps"), not a Kind-based reference, so there is no
+	// version to recover here: resolving the preferred version/Kind for a
+	// resource requires a RESTMapper/discovery client, which this package
+	// doesn't have access to.
+	groupResource := schema.ParseGroupResource(definitionRef)
+	return schema.GroupVersionResource{Group: groupResource.Group, Resource: groupResource.Resource}, nil
+}
+
+// listScopeDefinitionNames lists the names of all ScopeDefinitions on the cluster
+func listScopeDefinitionNames(client dynamic.Interface) ([]string, error) {
+	list, err := client.Resource(scopeDefinitionGVR).List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list ScopeDefinitions")
+	}
+
+	var names []string
+	for _, item := range list.Items {
+		names = append(names, item.GetName())
+	}
+	return names, nil
+}
+
+// cueParameterBlockPattern matches the top-level "parameter: { ... }" struct
+// in a ComponentDefinition's CUE template.
+var cueParameterBlockPattern = regexp.MustCompile(`(?s)parameter:\s*\{(.*?)\n\}`)
+
+// cueFieldPattern matches a single field declaration inside a parameter
+// block, e.g. "image: string" or "replicas?: *1 | int".
+var cueFieldPattern = regexp.MustCompile(`(?m)^\s*([A-Za-z_][A-Za-z0-9_]*)(\??):\s*(.+)$`)
+
+// parseCUEParameters extracts the fields declared in a ComponentDefinition's
+// CUE "parameter" struct. This is a best-effort, regex-based reading of the
+// common case (a flat struct of scalar fields); it is not a CUE parser, and
+// will not understand nested structs, comprehensions or imports.
+func parseCUEParameters(template string) []OAMParameter {
+	block := cueParameterBlockPattern.FindStringSubmatch(template)
+	if len(block) != 2 {
+		return nil
+	}
+
+	var params []OAMParameter
+	for _, match := range cueFieldPattern.FindAllStringSubmatch(block[1], -1) {
+		params = append(params, OAMParameter{
+			Name:     match[1],
+			Type:     strings.TrimSpace(match[3]),
+			Required: match[2] != "?",
+		})
+	}
+	return params
+}
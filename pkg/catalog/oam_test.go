@@ -0,0 +1,138 @@
+package catalog
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+// componentDefinitionFixture and workloadDefinitionFixture below follow the
+// real core.oam.dev/v1alpha2 CRD shapes: a WorkloadDefinition's
+// spec.definitionRef.name is the plain "<plural>.<group>" CRD name of the
+// workload it describes, and a ComponentDefinition's CUE schematic carries a
+// raw CUE text template, not a structured parameter list.
+func componentDefinitionFixture() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "core.oam.dev/v1alpha2",
+		"kind":       "ComponentDefinition",
+		"metadata": map[string]interface{}{
+			"name": "webservice",
+		},
+		"spec": map[string]interface{}{
+			"workload": map[string]interface{}{
+				"definitionRef": map[string]interface{}{
+					"name": "deployments.apps",
+				},
+			},
+			"schematic": map[string]interface{}{
+				"cue": map[string]interface{}{
+					"template": "parameter: {\n\timage: string\n\tport?: *80 | int\n}\noutput: {\n\tapiVersion: \"apps/v1\"\n}",
+				},
+			},
+			"extension": map[string]interface{}{
+				"traits": []interface{}{"scaler", "ingress"},
+			},
+		},
+	}}
+}
+
+func workloadDefinitionFixture() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "core.oam.dev/v1alpha2",
+		"kind":       "WorkloadDefinition",
+		"metadata": map[string]interface{}{
+			"name": "deployments.apps",
+		},
+		"spec": map[string]interface{}{
+			"definitionRef": map[string]interface{}{
+				"name": "deployments.apps",
+			},
+		},
+	}}
+}
+
+func fakeDynamicClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	scheme := runtime.NewScheme()
+	listKinds := map[schema.GroupVersionResource]string{
+		componentDefinitionGVR: "ComponentDefinitionList",
+		workloadDefinitionGVR:  "WorkloadDefinitionList",
+		scopeDefinitionGVR:     "ScopeDefinitionList",
+	}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(scheme, listKinds, objects...)
+}
+
+func TestGetOAMComponent(t *testing.T) {
+	client := fakeDynamicClient(componentDefinitionFixture(), workloadDefinitionFixture())
+
+	component, err := GetOAMComponent(client, "webservice")
+	if err != nil {
+		t.Fatalf("GetOAMComponent() returned unexpected error: %v", err)
+	}
+
+	if component.Name != "webservice" {
+		t.Errorf("Name = %q, want %q", component.Name, "webservice")
+	}
+
+	wantGVR := schema.GroupVersionResource{Group: "apps", Resource: "deployments"}
+	if component.WorkloadGVR != wantGVR {
+		t.Errorf("WorkloadGVR = %v, want %v", component.WorkloadGVR, wantGVR)
+	}
+
+	wantParams := []OAMParameter{
+		{Name: "image", Type: "string", Required: true},
+		{Name: "port", Type: "*80 | int", Required: false},
+	}
+	if !reflect.DeepEqual(component.Parameters, wantParams) {
+		t.Errorf("Parameters = %+v, want %+v", component.Parameters, wantParams)
+	}
+
+	wantTraits := []string{"scaler", "ingress"}
+	if !reflect.DeepEqual(component.Traits, wantTraits) {
+		t.Errorf("Traits = %v, want %v", component.Traits, wantTraits)
+	}
+}
+
+func TestGetOAMComponentNotFound(t *testing.T) {
+	client := fakeDynamicClient()
+
+	if _, err := GetOAMComponent(client, "does-not-exist"); err == nil {
+		t.Error("expected an error for a ComponentDefinition that doesn't exist, got nil")
+	}
+}
+
+func TestResolveWorkloadGVR(t *testing.T) {
+	client := fakeDynamicClient(workloadDefinitionFixture())
+
+	gvr, err := resolveWorkloadGVR(client, "deployments.apps")
+	if err != nil {
+		t.Fatalf("resolveWorkloadGVR() returned unexpected error: %v", err)
+	}
+
+	want := schema.GroupVersionResource{Group: "apps", Resource: "deployments"}
+	if gvr != want {
+		t.Errorf("resolveWorkloadGVR() = %v, want %v", gvr, want)
+	}
+}
+
+func TestParseCUEParameters(t *testing.T) {
+	template := "parameter: {\n\timage: string\n\tport?: *80 | int\n}\noutput: {\n\tapiVersion: \"apps/v1\"\n}"
+
+	got := parseCUEParameters(template)
+	want := []OAMParameter{
+		{Name: "image", Type: "string", Required: true},
+		{Name: "port", Type: "*80 | int", Required: false},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseCUEParameters() = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseCUEParametersNoParameterBlock(t *testing.T) {
+	if got := parseCUEParameters("output: {\n\tapiVersion: \"apps/v1\"\n}"); got != nil {
+		t.Errorf("parseCUEParameters() = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,177 @@
+package component
+
+import (
+	"context"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/generator"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"github.com/pkg/errors"
+
+	adaptersCommon "github.com/openshift/odo/pkg/devfile/adapters/common"
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/openshift/odo/pkg/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// componentLabel is the label key used to select the Deployment and Pod(s) backing a devfile component
+const componentLabel = "component"
+
+// Adapter is the Kubernetes-specific devfile component adapter. It backs a
+// devfile component with a single Deployment, named and labelled after the
+// component (the devfile's container components are checked to exist but are
+// not written into the Deployment's pod template), and answers readiness/existence questions about it through the typed
+// WaitForCondition poller in wait.go rather than ad-hoc watches.
+type Adapter struct {
+	Client         kclient.Client
+	AdapterContext adaptersCommon.AdapterContext
+}
+
+// New creates a new Kubernetes component Adapter for the given devfile component
+func New(adapterContext adaptersCommon.AdapterContext, client kclient.Client) Adapter {
+	return Adapter{
+		Client:         client,
+		AdapterContext: adapterContext,
+	}
+}
+
+// createOrUpdateComponent creates the Deployment backing this devfile
+// component, or updates it in place if running is true.
+func (a Adapter) createOrUpdateComponent(running bool, ei envinfo.EnvSpecificInfo) error {
+	containers, err := a.AdapterContext.Devfile.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+	})
+	if err != nil {
+		return err
+	}
+	if len(containers) == 0 {
+		return errors.Errorf("no valid components found in the devfile for component %q", a.AdapterContext.ComponentName)
+	}
+
+	deploymentSpec := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   a.AdapterContext.ComponentName,
+			Labels: map[string]string{componentLabel: a.AdapterContext.ComponentName},
+		},
+	}
+
+	deployments := a.Client.KubeClient.AppsV1().Deployments(a.Client.Namespace)
+	if running {
+		if _, err := deployments.Get(context.TODO(), a.AdapterContext.ComponentName, metav1.GetOptions{}); err != nil {
+			return errors.Wrapf(err, "unable to get the existing deployment for component %q", a.AdapterContext.ComponentName)
+		}
+		if _, err := deployments.Update(context.TODO(), deploymentSpec, metav1.UpdateOptions{}); err != nil {
+			return errors.Wrapf(err, "unable to update the deployment for component %q", a.AdapterContext.ComponentName)
+		}
+	} else {
+		if _, err := deployments.Create(context.TODO(), deploymentSpec, metav1.CreateOptions{}); err != nil {
+			return errors.Wrapf(err, "unable to create the deployment for component %q", a.AdapterContext.ComponentName)
+		}
+	}
+
+	// Confirm the deployment is actually reachable through the same typed poller
+	// DoesComponentExist and getPod use, rather than trusting the call above blindly.
+	return a.waitForDeploymentToExist(a.AdapterContext.ComponentName)
+}
+
+// DoesComponentExist returns true if a Deployment with the given name exists for this component
+func (a Adapter) DoesComponentExist(name string) (bool, error) {
+	err := a.waitForDeploymentToExist(name)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// waitForDeploymentToExist is a single-shot existence check for a
+// Deployment, implemented on top of WaitForCondition so that this
+// existence check and getPod's readiness check share the same typed
+// poller instead of each call site building its own client plumbing.
+func (a Adapter) waitForDeploymentToExist(name string) error {
+	// This is a single Get, not a real wait: cancel the context up front so
+	// WaitForCondition's poll loop runs its (Immediate) check exactly once.
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	return WaitForCondition(ctx, a.Client.KubeClient, a.Client.Namespace, "Deployment", name, func(obj runtime.Object) (bool, error) {
+		return true, nil
+	})
+}
+
+// getPod returns the Pod backing this component, waiting for it to reach
+// the Running phase via WaitForCondition/PodRunning.
+func (a Adapter) getPod() (*corev1.Pod, error) {
+	selector := util.ConvertLabelsToSelector(map[string]string{componentLabel: a.AdapterContext.ComponentName})
+
+	pods, err := a.Client.KubeClient.CoreV1().Pods(a.Client.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to list the pods for component %q", a.AdapterContext.ComponentName)
+	}
+	if len(pods.Items) == 0 {
+		return nil, errors.Errorf("unable to find a pod for component %q", a.AdapterContext.ComponentName)
+	}
+	podName := pods.Items[0].Name
+
+	if err := WaitForCondition(context.TODO(), a.Client.KubeClient, a.Client.Namespace, "Pod", podName, PodRunning); err != nil {
+		return nil, err
+	}
+
+	pod, err := a.Client.KubeClient.CoreV1().Pods(a.Client.Namespace).Get(context.TODO(), podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the pod for component %q", a.AdapterContext.ComponentName)
+	}
+	return pod, nil
+}
+
+// Delete removes the Deployment(s) matching the given labels, then lists the
+// Pods matching them. It does not delete Pods itself.
+func (a Adapter) Delete(labels map[string]string, show bool) error {
+	if len(labels) == 0 {
+		return errors.Errorf("unable to delete component %q: no selector labels provided", a.AdapterContext.ComponentName)
+	}
+	selector := util.ConvertLabelsToSelector(labels)
+
+	if err := a.Client.KubeClient.AppsV1().Deployments(a.Client.Namespace).DeleteCollection(context.TODO(), metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: selector}); err != nil {
+		return errors.Wrap(err, "unable to delete the deployment")
+	}
+
+	_, err := a.Client.KubeClient.CoreV1().Pods(a.Client.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		if _, ok := err.(*kclient.PodNotFoundError); ok {
+			// No pods left to clean up, which is the common case once the deployment's
+			// replica set has already been reaped.
+			return nil
+		}
+		if kerrors.IsForbidden(err) {
+			// The user may not have permission to list pods directly; that's not fatal to deletion.
+			return nil
+		}
+		return errors.Wrap(err, "unable to list the pods for component")
+	}
+
+	return nil
+}
+
+// getFirstContainerWithSourceVolume returns the first container, and the
+// project source path declared on it, that has the devfile project source
+// env var set.
+func getFirstContainerWithSourceVolume(containers []corev1.Container) (string, string, error) {
+	for _, container := range containers {
+		for _, env := range container.Env {
+			if env.Name == generator.EnvProjectsSrc {
+				return container.Name, env.Value, nil
+			}
+		}
+	}
+
+	return "", "", errors.Errorf("in order to sync files, a container should have %s environment variable set", generator.EnvProjectsSrc)
+}
@@ -21,7 +21,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/apimachinery/pkg/watch"
 	ktesting "k8s.io/client-go/testing"
 )
 
@@ -271,13 +270,11 @@ func TestDoesComponentExist(t *testing.T) {
 			}
 
 			fkclient, fkclientset := kclient.FakeNew()
-			fkWatch := watch.NewFake()
-
-			fkclientset.Kubernetes.PrependWatchReactor("pods", func(action ktesting.Action) (handled bool, ret watch.Interface, err error) {
-				return true, fkWatch, nil
-			})
 
 			// DoesComponentExist requires an already started component, so start it.
+			// createOrUpdateComponent no longer waits on a pod watch to do so (it
+			// confirms the Deployment exists via WaitForCondition instead), so no
+			// pod scaffolding is needed here.
 			componentAdapter := New(adapterCtx, *fkclient)
 			err := componentAdapter.createOrUpdateComponent(false, tt.envInfo)
 
@@ -352,19 +349,20 @@ func TestWaitAndGetComponentPod(t *testing.T) {
 			}
 
 			fkclient, fkclientset := kclient.FakeNew()
-			fkWatch := watch.NewFake()
-
-			// Change the status
-			go func() {
-				fkWatch.Modify(kclient.FakePodStatus(tt.status, testComponentName))
-			}()
 
-			fkclientset.Kubernetes.PrependWatchReactor("pods", func(action ktesting.Action) (handled bool, ret watch.Interface, err error) {
-				return true, fkWatch, nil
+			// getPod now polls the typed clientset through WaitForCondition/PodRunning
+			// instead of watching for status updates, so the fake only needs to answer
+			// Get/List with the pod already in its final phase.
+			pod := kclient.FakePodStatus(tt.status, testComponentName)
+			fkclientset.Kubernetes.PrependReactor("list", "pods", func(action ktesting.Action) (handled bool, ret runtime.Object, err error) {
+				return true, &corev1.PodList{Items: []corev1.Pod{*pod}}, nil
+			})
+			fkclientset.Kubernetes.PrependReactor("get", "pods", func(action ktesting.Action) (handled bool, ret runtime.Object, err error) {
+				return true, pod, nil
 			})
 
 			componentAdapter := New(adapterCtx, *fkclient)
-			_, err := componentAdapter.getPod(false)
+			_, err := componentAdapter.getPod()
 
 			// Checks for unexpected error cases
 			if !tt.wantErr == (err != nil) {
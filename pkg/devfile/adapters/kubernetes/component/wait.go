@@ -0,0 +1,168 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+)
+
+// conditionPollInterval and conditionPollTimeout bound how long
+// WaitForCondition will poll a resource before giving up. They mirror the
+// defaults used elsewhere in the adapter for readiness waits.
+const (
+	conditionPollInterval = 1 * time.Second
+	conditionPollTimeout  = 5 * time.Minute
+)
+
+// conditionFunc reports whether a polled object satisfies the condition
+// being waited on. It is only called once the resource exists; while the
+// resource is not found, WaitForCondition keeps polling without calling it.
+type conditionFunc func(obj runtime.Object) (bool, error)
+
+// resourceGetter fetches a single named resource of a given kind through the
+// typed clientset, returning it as a runtime.Object so WaitForCondition can
+// stay kind-agnostic.
+type resourceGetter func(client kubernetes.Interface, namespace, name string) (runtime.Object, error)
+
+var resourceGetters = map[string]resourceGetter{
+	"Pod": func(client kubernetes.Interface, namespace, name string) (runtime.Object, error) {
+		return client.CoreV1().Pods(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	},
+	"Deployment": func(client kubernetes.Interface, namespace, name string) (runtime.Object, error) {
+		return client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	},
+	"Job": func(client kubernetes.Interface, namespace, name string) (runtime.Object, error) {
+		return client.BatchV1().Jobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	},
+	"PersistentVolumeClaim": func(client kubernetes.Interface, namespace, name string) (runtime.Object, error) {
+		return client.CoreV1().PersistentVolumeClaims(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	},
+}
+
+// WaitForCondition polls a single named resource of the given kind until
+// cond reports it ready, ctx is cancelled, or the poll times out. It
+// replaces the ad-hoc watch.Interface scaffolding previously built per call
+// site (see getPod) with a single typed poll loop that every readiness check
+// in this adapter can share.
+//
+// kind must be one of "Pod", "Deployment", "Job" or "PersistentVolumeClaim".
+func WaitForCondition(ctx context.Context, client kubernetes.Interface, namespace, kind, name string, cond conditionFunc) error {
+	getter, ok := resourceGetters[kind]
+	if !ok {
+		return errors.Errorf("WaitForCondition: unsupported resource kind %q", kind)
+	}
+
+	// Apply the default timeout ourselves unless the caller already set a
+	// deadline, so conditionPollTimeout is always the effective upper bound
+	// rather than just documentation.
+	pollCtx := ctx
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		pollCtx, cancel = context.WithTimeout(ctx, conditionPollTimeout)
+		defer cancel()
+	}
+
+	var lastNotFoundErr error
+	err := wait.PollImmediateUntil(conditionPollInterval, func() (bool, error) {
+		obj, err := getter(client, namespace, name)
+		if err != nil {
+			if kerrors.IsNotFound(err) {
+				lastNotFoundErr = err
+				return false, nil
+			}
+			// Any other error talking to the API is not something retrying will fix.
+			return false, err
+		}
+
+		// A cond error (e.g. a Pod that has already Failed) is terminal too: stop
+		// polling immediately instead of waiting out the full timeout.
+		return cond(obj)
+	}, pollCtx.Done())
+
+	if err != nil {
+		if lastNotFoundErr != nil {
+			return lastNotFoundErr
+		}
+		return errors.Wrapf(err, "%s %q never became ready", kind, name)
+	}
+	return nil
+}
+
+// PodRunning is a conditionFunc satisfied once the given Pod has entered
+// the Running phase. It fails fast on Failed/Unknown, matching the
+// behaviour of the watch-based getPod it replaces.
+func PodRunning(obj runtime.Object) (bool, error) {
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return false, errors.Errorf("PodRunning: unexpected object type %T", obj)
+	}
+
+	switch pod.Status.Phase {
+	case corev1.PodRunning:
+		return true, nil
+	case corev1.PodFailed, corev1.PodUnknown:
+		return false, fmt.Errorf("pod %q is in %s phase", pod.Name, pod.Status.Phase)
+	default:
+		return false, nil
+	}
+}
+
+// DeploymentAvailable is a conditionFunc satisfied once a Deployment's
+// Available condition is true and all its replicas have been updated.
+func DeploymentAvailable(obj runtime.Object) (bool, error) {
+	deployment, ok := obj.(*appsv1.Deployment)
+	if !ok {
+		return false, errors.Errorf("DeploymentAvailable: unexpected object type %T", obj)
+	}
+
+	// Replicas is nil until the apiserver defaults it (defaults to 1), so a nil
+	// pointer here isn't an invariant violation and must not be dereferenced blindly.
+	wantReplicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		wantReplicas = *deployment.Spec.Replicas
+	}
+	if deployment.Status.UpdatedReplicas < wantReplicas {
+		return false, nil
+	}
+
+	for _, cond := range deployment.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable && cond.Status == corev1.ConditionTrue {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// JobSucceeded is a conditionFunc satisfied once a Job reports at least one
+// succeeded pod, and fails fast if the Job reports a failure.
+func JobSucceeded(obj runtime.Object) (bool, error) {
+	job, ok := obj.(*batchv1.Job)
+	if !ok {
+		return false, errors.Errorf("JobSucceeded: unexpected object type %T", obj)
+	}
+
+	if job.Status.Failed > 0 {
+		return false, errors.Errorf("job %q failed", job.Name)
+	}
+	return job.Status.Succeeded > 0, nil
+}
+
+// PVCBound is a conditionFunc satisfied once a PersistentVolumeClaim is Bound.
+func PVCBound(obj runtime.Object) (bool, error) {
+	pvc, ok := obj.(*corev1.PersistentVolumeClaim)
+	if !ok {
+		return false, errors.Errorf("PVCBound: unexpected object type %T", obj)
+	}
+	return pvc.Status.Phase == corev1.ClaimBound, nil
+}
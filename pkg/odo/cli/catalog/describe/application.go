@@ -0,0 +1,218 @@
+package describe
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/openshift/odo/pkg/catalog"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util/pushtarget"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const applicationRecommendedCommandName = "application"
+
+var (
+	applicationExample = ktemplates.Examples(`  # Describe an application
+    %[1]s myapp`)
+
+	applicationLongDesc = ktemplates.LongDesc(`Describe an application.
+This describes all the components that make up the application and their starter projects.
+`)
+)
+
+// applicationComponent holds the devfile for a single component referenced by an application manifest,
+// along with the name it is known by within that application
+type applicationComponent struct {
+	// Name is the name of the component as declared by the application manifest
+	Name string `json:"name"`
+	// DependsOn lists the other component names in the application that this component depends on
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// Devfile is the parsed devfile.yaml for this component
+	Devfile parser.DevfileObj `json:"-"`
+}
+
+// applicationDescription is the aggregated JSON payload emitted under log.IsJSON() for
+// `odo catalog describe application`
+type applicationDescription struct {
+	Name       string                            `json:"name"`
+	Components []applicationComponentDescription `json:"components"`
+}
+
+// applicationComponentDescription is the per-component slice of an applicationDescription
+type applicationComponentDescription struct {
+	Name            string                     `json:"name"`
+	DependsOn       []string                   `json:"dependsOn,omitempty"`
+	StarterProjects []devfilev1.StarterProject `json:"starterProjects,omitempty"`
+	Containers      []devfilev1.Component      `json:"containerComponents,omitempty"`
+}
+
+// DescribeApplicationOptions encapsulates the options for the odo catalog describe application command
+type DescribeApplicationOptions struct {
+	// name of the application to describe, from command arguments
+	applicationName string
+	// application manifest resolved from the devfile registry, referencing one or more components
+	application catalog.DevfileApplicationType
+	// components referenced by the application, in manifest order
+	components []applicationComponent
+	// generic context options common to all commands
+	*genericclioptions.Context
+}
+
+// NewDescribeApplicationOptions creates a new DescribeApplicationOptions instance
+func NewDescribeApplicationOptions() *DescribeApplicationOptions {
+	return &DescribeApplicationOptions{}
+}
+
+// Complete completes DescribeApplicationOptions after they've been created
+func (o *DescribeApplicationOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.applicationName = args[0]
+
+	if !pushtarget.IsPushTargetDocker() {
+		o.Context, err = genericclioptions.NewContext(cmd, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	catalogApplicationList, err := catalog.ListDevfileApplications("")
+	if err != nil {
+		return err
+	}
+
+	for _, application := range catalogApplicationList.Items {
+		if application.Name == o.applicationName {
+			o.application = application
+			break
+		}
+	}
+
+	return nil
+}
+
+// Validate validates the DescribeApplicationOptions based on completed values
+func (o *DescribeApplicationOptions) Validate() (err error) {
+	if o.application.Name == "" {
+		return errors.Errorf("No application with the name \"%s\" found", o.applicationName)
+	}
+
+	return nil
+}
+
+// Run contains the logic for the command associated with DescribeApplicationOptions
+func (o *DescribeApplicationOptions) Run() (err error) {
+	for _, ref := range o.application.Components {
+		devObj, err := GetDevfile(ref.DevfileComponentType)
+		if err != nil {
+			return errors.Wrapf(err, "Failed to describe component \"%s\" of application \"%s\"", ref.ComponentName, o.applicationName)
+		}
+		o.components = append(o.components, applicationComponent{
+			Name:      ref.ComponentName,
+			DependsOn: ref.DependsOn,
+			Devfile:   devObj,
+		})
+	}
+
+	if log.IsJSON() {
+		return o.runJSON()
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	fmt.Fprintln(w, "Application: "+o.applicationName)
+
+	for _, component := range o.components {
+		fmt.Fprintln(w, "\n* Component: "+component.Name+" (registry: "+o.componentRegistry(component.Name)+")")
+		if len(component.DependsOn) > 0 {
+			fmt.Fprintln(w, "  Depends on: "+fmt.Sprint(component.DependsOn))
+		}
+
+		projects, err := component.Devfile.Data.GetStarterProjects(parsercommon.DevfileOptions{})
+		if err != nil {
+			return err
+		}
+
+		containers, err := component.Devfile.Data.GetComponents(parsercommon.DevfileOptions{
+			ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+		})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "  Container components: %d\n", len(containers))
+
+		if len(projects) > 0 {
+			fmt.Fprintln(w, "  Starter Projects:")
+			for _, project := range projects {
+				fmt.Fprintln(w, "  - "+project.Name)
+			}
+		}
+	}
+	fmt.Fprintln(w)
+
+	return w.Flush()
+}
+
+// runJSON emits the aggregated application description as a single JSON object
+func (o *DescribeApplicationOptions) runJSON() error {
+	description := applicationDescription{Name: o.applicationName}
+
+	for _, component := range o.components {
+		projects, err := component.Devfile.Data.GetStarterProjects(parsercommon.DevfileOptions{})
+		if err != nil {
+			return err
+		}
+
+		containers, err := component.Devfile.Data.GetComponents(parsercommon.DevfileOptions{
+			ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+		})
+		if err != nil {
+			return err
+		}
+
+		description.Components = append(description.Components, applicationComponentDescription{
+			Name:            component.Name,
+			DependsOn:       component.DependsOn,
+			StarterProjects: projects,
+			Containers:      containers,
+		})
+	}
+
+	machineoutput.OutputSuccess(description)
+	return nil
+}
+
+// componentRegistry returns the registry name a given component of the application was resolved from
+func (o *DescribeApplicationOptions) componentRegistry(componentName string) string {
+	for _, ref := range o.application.Components {
+		if ref.ComponentName == componentName {
+			return ref.Registry.Name
+		}
+	}
+	return ""
+}
+
+// NewCmdCatalogDescribeApplication implements the odo catalog describe application command
+func NewCmdCatalogDescribeApplication(name, fullName string) *cobra.Command {
+	o := NewDescribeApplicationOptions()
+	command := &cobra.Command{
+		Use:         name,
+		Short:       "Describe an application",
+		Long:        applicationLongDesc,
+		Example:     fmt.Sprintf(applicationExample, fullName),
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	return command
+}
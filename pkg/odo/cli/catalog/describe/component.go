@@ -2,7 +2,10 @@ package describe
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
@@ -44,6 +47,8 @@ type DescribeComponentOptions struct {
 	devfileComponents []catalog.DevfileComponentType
 	// if componentName is a classic/odov1 component
 	component string
+	// if an OAM ComponentDefinition with name that matches arg[0] is found on the cluster
+	oamComponent *catalog.OAMComponentType
 	// generic context options common to all commands
 	*genericclioptions.Context
 }
@@ -91,12 +96,35 @@ func (o *DescribeComponentOptions) Complete(name string, cmd *cobra.Command, arg
 		o.GetDevfileComponentsByName(catalogDevfileList)
 	}})
 
+	if !pushtarget.IsPushTargetDocker() {
+		tasks.Add(util.ConcurrentTask{ToRun: func(errChannel chan error) {
+			dynamicClient, err := o.Context.Client.GetDynamicClient()
+			if err != nil {
+				// Not every cluster exposes a dynamic client (or is reachable at all at
+				// this point), and most clusters won't have an OAM control plane installed.
+				// Same best-effort posture as the s2i lookup above.
+				klog.V(4).Infof("Unable to get a dynamic client to look up OAM components: %v", err)
+				return
+			}
+			if !catalog.IsOAMSupported(dynamicClient) {
+				klog.V(4).Info("No OAM control plane (ComponentDefinition CRD) found on the cluster")
+				return
+			}
+			oamComponent, err := catalog.GetOAMComponent(dynamicClient, o.componentName)
+			if err != nil {
+				klog.V(4).Infof("No OAM ComponentDefinition named \"%s\" found: %v", o.componentName, err)
+				return
+			}
+			o.oamComponent = &oamComponent
+		}})
+	}
+
 	return tasks.Run()
 }
 
 // Validate validates the DescribeComponentOptions based on completed values
 func (o *DescribeComponentOptions) Validate() (err error) {
-	if len(o.devfileComponents) == 0 && o.component == "" {
+	if len(o.devfileComponents) == 0 && o.component == "" && o.oamComponent == nil {
 		return errors.Wrapf(err, "No components with the name \"%s\" found", o.componentName)
 	}
 
@@ -105,51 +133,77 @@ func (o *DescribeComponentOptions) Validate() (err error) {
 
 // Run contains the logic for the command associated with DescribeComponentOptions
 func (o *DescribeComponentOptions) Run() (err error) {
-	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
 	if log.IsJSON() {
-		if len(o.devfileComponents) > 0 {
-			for _, devfileComponent := range o.devfileComponents {
-				devObj, err := GetDevfile(devfileComponent)
-				if err != nil {
-					return err
-				}
+		return o.runJSON()
+	}
 
-				machineoutput.OutputSuccess(devObj)
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	if len(o.devfileComponents) > 1 {
+		log.Warningf("There are multiple components named \"%s\" in different multiple devfile registries.\n", o.componentName)
+	}
+	if len(o.devfileComponents) > 0 {
+		fmt.Fprintln(w, "Devfile Component(s):")
+
+		for _, devfileComponent := range o.devfileComponents {
+			fmt.Fprintln(w, "\n* Registry: "+devfileComponent.Registry.Name)
+
+			devObj, err := GetDevfile(devfileComponent)
+			if err != nil {
+				return err
+			}
+
+			projects, err := devObj.Data.GetStarterProjects(parsercommon.DevfileOptions{})
+			if err != nil {
+				return err
+			}
+			// print the starter projects, or the whole devfile if it has none
+			err = o.PrintDevfileStarterProjects(w, projects, devObj)
+			if err != nil {
+				return err
 			}
 		}
 	} else {
-		if len(o.devfileComponents) > 1 {
-			log.Warningf("There are multiple components named \"%s\" in different multiple devfile registries.\n", o.componentName)
+		fmt.Fprintln(w, "There are no Odo devfile components with the name \""+o.componentName+"\"")
+	}
+	if o.component != "" {
+		fmt.Fprintln(w, "\nS2I Based Components:")
+		fmt.Fprintln(w, "-"+o.component)
+	}
+	if o.oamComponent != nil {
+		fmt.Fprintln(w, "\nOAM Component:")
+		fmt.Fprintln(w, "Workload: "+o.oamComponent.WorkloadGVR.String())
+		if len(o.oamComponent.Parameters) > 0 {
+			fmt.Fprintln(w, "Parameters:")
+			for _, param := range o.oamComponent.Parameters {
+				fmt.Fprintf(w, "- %s (%s)\n", param.Name, param.Type)
+			}
 		}
-		if len(o.devfileComponents) > 0 {
-			fmt.Fprintln(w, "Devfile Component(s):")
-
-			for _, devfileComponent := range o.devfileComponents {
-				fmt.Fprintln(w, "\n* Registry: "+devfileComponent.Registry.Name)
+		if len(o.oamComponent.Traits) > 0 {
+			fmt.Fprintln(w, "Traits: "+strings.Join(o.oamComponent.Traits, ", "))
+		}
+		if len(o.oamComponent.Scopes) > 0 {
+			fmt.Fprintln(w, "Scopes: "+strings.Join(o.oamComponent.Scopes, ", "))
+		}
+	}
+	fmt.Fprintln(w)
 
-				devObj, err := GetDevfile(devfileComponent)
-				if err != nil {
-					return err
-				}
+	return nil
+}
 
-				projects, err := devObj.Data.GetStarterProjects(parsercommon.DevfileOptions{})
-				if err != nil {
-					return err
-				}
-				// only print project info if there is at least one project in the devfile
-				err = o.PrintDevfileStarterProjects(w, projects, devObj)
-				if err != nil {
-					return err
-				}
+// runJSON emits the devfile and/or OAM component description(s) as machine-readable JSON
+func (o *DescribeComponentOptions) runJSON() error {
+	if len(o.devfileComponents) > 0 {
+		for _, devfileComponent := range o.devfileComponents {
+			devObj, err := GetDevfile(devfileComponent)
+			if err != nil {
+				return err
 			}
-		} else {
-			fmt.Fprintln(w, "There are no Odo devfile components with the name \""+o.componentName+"\"")
-		}
-		if o.component != "" {
-			fmt.Fprintln(w, "\nS2I Based Components:")
-			fmt.Fprintln(w, "-"+o.component)
+
+			machineoutput.OutputSuccess(devObj)
 		}
-		fmt.Fprintln(w)
+	}
+	if o.oamComponent != nil {
+		machineoutput.OutputSuccess(o.oamComponent)
 	}
 
 	return nil
@@ -182,14 +236,50 @@ func (o *DescribeComponentOptions) GetDevfileComponentsByName(catalogDevfileList
 	}
 }
 
+// RegistryClient abstracts the HTTP client used to download devfile.yaml
+// content from a devfile registry, so tests can inject a fake registry
+// server instead of reaching out to a real HTTPS URL.
+type RegistryClient interface {
+	Get(url string) (*http.Response, error)
+}
+
+// DefaultRegistryClient is the RegistryClient used by GetDevfile outside of
+// tests. It is a var, rather than a hardcoded http.DefaultClient reference,
+// so it can be swapped wholesale if callers ever need a non-default
+// transport (proxies, custom TLS, etc).
+var DefaultRegistryClient RegistryClient = http.DefaultClient
+
 // GetDevfile downloads the devfile in memory and return the devfile object
 func GetDevfile(devfileComponent catalog.DevfileComponentType) (parser.DevfileObj, error) {
+	return GetDevfileWithClient(devfileComponent, DefaultRegistryClient)
+}
+
+// GetDevfileWithClient is GetDevfile with an injectable RegistryClient, for
+// callers that need to download through a client other than
+// DefaultRegistryClient.
+func GetDevfileWithClient(devfileComponent catalog.DevfileComponentType, client RegistryClient) (parser.DevfileObj, error) {
 	var devObj parser.DevfileObj
 
-	devObj, err := devfile.ParseFromURLAndValidate(devfileComponent.Registry.URL + devfileComponent.Link)
+	devfileURL := devfileComponent.Registry.URL + devfileComponent.Link
+	resp, err := client.Get(devfileURL)
 	if err != nil {
 		return devObj, errors.Wrapf(err, "Failed to download devfile.yaml for devfile component: %s", devfileComponent.Name)
 	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return devObj, errors.Errorf("Failed to download devfile.yaml for devfile component: %s: unexpected status %s", devfileComponent.Name, resp.Status)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return devObj, errors.Wrapf(err, "Failed to read devfile.yaml for devfile component: %s", devfileComponent.Name)
+	}
+
+	devObj, err = devfile.ParseFromData(data)
+	if err != nil {
+		return devObj, errors.Wrapf(err, "Failed to parse devfile.yaml for devfile component: %s", devfileComponent.Name)
+	}
 	err = validate.ValidateDevfileData(devObj.Data)
 	if err != nil {
 		return devObj, err
@@ -0,0 +1,170 @@
+package describe
+
+import (
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/openshift/odo/pkg/catalog"
+	"github.com/openshift/odo/pkg/testingutil/registry"
+)
+
+const validDevfile = `
+schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: quay.io/nodejs/nodejs:latest
+`
+
+const validDevfileWithStarterProject = `
+schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: quay.io/nodejs/nodejs:latest
+starterProjects:
+  - name: nodejs-starter
+    git:
+      remotes:
+        origin: https://github.com/odo-devfiles/nodejs-ex.git
+`
+
+// captureStdout runs fn with os.Stdout redirected to a pipe, and returns
+// everything written to it. Run() and PrintDevfileStarterProjects write
+// directly to os.Stdout rather than through the tabwriter in a couple of
+// places, so tests have to capture at the fd level.
+func captureStdout(t *testing.T, fn func() error) (string, error) {
+	t.Helper()
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatalf("failed to create pipe: %v", err)
+	}
+	orig := os.Stdout
+	os.Stdout = w
+
+	runErr := fn()
+
+	os.Stdout = orig
+	w.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("failed to read captured stdout: %v", err)
+	}
+
+	return string(out), runErr
+}
+
+func TestDescribeComponentOptionsRun(t *testing.T) {
+	fakeRegistry := registry.NewFakeRegistry()
+	defer fakeRegistry.Close()
+
+	noStarterLink := fakeRegistry.AddDevfile("nodejs-a", []byte(validDevfile))
+	starterLink := fakeRegistry.AddDevfile("nodejs-b", []byte(validDevfileWithStarterProject))
+	malformedLink := "/devfiles/broken/devfile.yaml"
+	fakeRegistry.AddMalformedDevfile(malformedLink, []byte("not: [valid"))
+
+	registryA := catalog.Registry{Name: "registryA", URL: fakeRegistry.URL()}
+	registryB := catalog.Registry{Name: "registryB", URL: fakeRegistry.URL()}
+
+	// Point GetDevfile at the fake registry server for the duration of this test.
+	origClient := DefaultRegistryClient
+	DefaultRegistryClient = fakeRegistry.HTTPClient()
+	defer func() { DefaultRegistryClient = origClient }()
+
+	tests := []struct {
+		name              string
+		devfileComponents []catalog.DevfileComponentType
+		wantErr           bool
+		wantContains      []string
+	}{
+		{
+			name: "single registry, no starter projects",
+			devfileComponents: []catalog.DevfileComponentType{
+				{Name: "nodejs-a", Registry: registryA, Link: noStarterLink},
+			},
+			wantContains: []string{"has no starter projects"},
+		},
+		{
+			name: "single registry, with starter project",
+			devfileComponents: []catalog.DevfileComponentType{
+				{Name: "nodejs-b", Registry: registryA, Link: starterLink},
+			},
+			wantContains: []string{"Starter Projects:"},
+		},
+		{
+			name: "name collision across multiple registries",
+			devfileComponents: []catalog.DevfileComponentType{
+				{Name: "nodejs", Registry: registryA, Link: noStarterLink},
+				{Name: "nodejs", Registry: registryB, Link: starterLink},
+			},
+			wantContains: []string{"Registry: registryA", "Registry: registryB"},
+		},
+		{
+			name: "malformed devfile",
+			devfileComponents: []catalog.DevfileComponentType{
+				{Name: "broken", Registry: registryA, Link: malformedLink},
+			},
+			wantErr: true,
+		},
+		{
+			name:              "no devfile components found",
+			devfileComponents: nil,
+			wantContains:      []string{"There are no Odo devfile components"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &DescribeComponentOptions{
+				componentName:     "nodejs",
+				devfileComponents: tt.devfileComponents,
+			}
+
+			out, err := captureStdout(t, o.Run)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			for _, want := range tt.wantContains {
+				if !strings.Contains(out, want) {
+					t.Errorf("expected output to contain %q, got:\n%s", want, out)
+				}
+			}
+		})
+	}
+}
+
+func TestDescribeComponentOptionsRunJSON(t *testing.T) {
+	fakeRegistry := registry.NewFakeRegistry()
+	defer fakeRegistry.Close()
+
+	link := fakeRegistry.AddDevfile("nodejs-a", []byte(validDevfile))
+	reg := catalog.Registry{Name: "registryA", URL: fakeRegistry.URL()}
+
+	origClient := DefaultRegistryClient
+	DefaultRegistryClient = fakeRegistry.HTTPClient()
+	defer func() { DefaultRegistryClient = origClient }()
+
+	o := &DescribeComponentOptions{
+		componentName: "nodejs-a",
+		devfileComponents: []catalog.DevfileComponentType{
+			{Name: "nodejs-a", Registry: reg, Link: link},
+		},
+	}
+
+	out, err := captureStdout(t, o.runJSON)
+	if err != nil {
+		t.Fatalf("runJSON() unexpected error: %v", err)
+	}
+	if !strings.Contains(out, "nodejs") {
+		t.Errorf("expected JSON output to mention the devfile component, got:\n%s", out)
+	}
+}
@@ -0,0 +1,32 @@
+package describe
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// RecommendedCommandName is the recommended describe command name
+const RecommendedCommandName = "describe"
+
+var describeLongDesc = ktemplates.LongDesc(`Describe catalog items.`)
+
+// NewCmdCatalogDescribe implements the odo catalog describe command
+func NewCmdCatalogDescribe(name, fullName string) *cobra.Command {
+	componentCommand := NewCmdCatalogDescribeComponent(componentRecommendedCommandName, fmt.Sprintf("%s %s", fullName, componentRecommendedCommandName))
+	applicationCommand := NewCmdCatalogDescribeApplication(applicationRecommendedCommandName, fmt.Sprintf("%s %s", fullName, applicationRecommendedCommandName))
+
+	command := &cobra.Command{
+		Use:   name,
+		Short: "Describe catalog item",
+		Long:  describeLongDesc,
+		Example: fmt.Sprintf("%s\n%s",
+			componentCommand.Example,
+			applicationCommand.Example),
+	}
+
+	command.AddCommand(componentCommand, applicationCommand)
+
+	return command
+}
@@ -0,0 +1,89 @@
+// Package registry provides an in-process fake devfile registry server for
+// tests that need to exercise code paths downloading devfile.yaml content
+// (e.g. describe.GetDevfile) without reaching out to a real HTTPS registry.
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+)
+
+// IndexEntry is a single devfile stack entry as served by a registry's index.json
+type IndexEntry struct {
+	Name  string `json:"name"`
+	Links struct {
+		Self string `json:"self"`
+	} `json:"links"`
+}
+
+// FakeRegistry is an in-memory devfile registry backed by an httptest.Server.
+// It serves an index.json built from the entries it has been given, and the
+// raw devfile.yaml content registered for each entry's link.
+type FakeRegistry struct {
+	server   *httptest.Server
+	index    []IndexEntry
+	devfiles map[string][]byte
+}
+
+// NewFakeRegistry starts an in-process HTTP server that serves a devfile
+// registry index.json at "/index.json" and any devfile.yaml content
+// registered with AddDevfile at its declared link.
+func NewFakeRegistry() *FakeRegistry {
+	r := &FakeRegistry{devfiles: map[string][]byte{}}
+	r.server = httptest.NewServer(http.HandlerFunc(r.handle))
+	return r
+}
+
+// URL returns the base URL of the fake registry, suitable for use as a catalog.Registry.URL
+func (r *FakeRegistry) URL() string {
+	return r.server.URL
+}
+
+// Close shuts down the underlying httptest.Server
+func (r *FakeRegistry) Close() {
+	r.server.Close()
+}
+
+// HTTPClient returns the http.Client the httptest.Server was configured
+// with, for callers that need to inject it in place of http.DefaultClient
+// (e.g. describe.DefaultRegistryClient).
+func (r *FakeRegistry) HTTPClient() *http.Client {
+	return r.server.Client()
+}
+
+// AddDevfile registers a devfile stack named "name", with the given raw
+// devfile.yaml content, and adds a corresponding entry to the served
+// index.json. The link the entry is reachable under is returned, for use as
+// a catalog.DevfileComponentType.Link.
+func (r *FakeRegistry) AddDevfile(name string, devfileYAML []byte) string {
+	link := fmt.Sprintf("/devfiles/%s/devfile.yaml", name)
+	r.index = append(r.index, IndexEntry{Name: name, Links: struct {
+		Self string `json:"self"`
+	}{Self: link}})
+	r.devfiles[link] = devfileYAML
+	return link
+}
+
+// AddMalformedDevfile registers arbitrary, possibly invalid, bytes at the
+// given link, without adding an index.json entry for it. This is useful for
+// exercising devfile parse/validate failure paths directly by link.
+func (r *FakeRegistry) AddMalformedDevfile(link string, content []byte) {
+	r.devfiles[link] = content
+}
+
+func (r *FakeRegistry) handle(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/index.json" {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(r.index)
+		return
+	}
+
+	content, ok := r.devfiles[req.URL.Path]
+	if !ok {
+		http.NotFound(w, req)
+		return
+	}
+	w.Write(content)
+}